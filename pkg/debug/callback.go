@@ -0,0 +1,127 @@
+package debug
+
+import (
+	"log/slog"
+	"unsafe"
+
+	gl43 "github.com/go-gl/gl/v4.3-core/gl"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+)
+
+// Severity gates which GL_DEBUG_SEVERITY_* messages InitCallback forwards
+// to slog; lower-severity messages are still recorded in the ring buffer.
+type Severity int
+
+const (
+	SeverityNotification Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+)
+
+// InitCallback registers a Go callback via gl.DebugMessageCallback when the
+// context exposes GL_KHR_debug (core in GL 4.3+), translating each message
+// into a structured slog entry filtered by minSeverity. It reports whether
+// the callback was installed; callers should fall back to CheckError when
+// it returns false.
+//
+// Contexts that only expose GL_ARB_debug_output (pre-4.3 drivers) aren't
+// handled here: that extension's entry point is glDebugMessageCallbackARB,
+// which the 4.3-core binding used for the callback path doesn't export, so
+// calling through it would crash rather than degrade gracefully. Those
+// contexts fall back to CheckError like any other context without
+// GL_KHR_debug.
+func InitCallback(minSeverity Severity) bool {
+	if !hasExtension("GL_KHR_debug") {
+		return false
+	}
+
+	if err := gl43.Init(); err != nil {
+		return false
+	}
+
+	gl43.Enable(gl43.DEBUG_OUTPUT)
+	gl43.Enable(gl43.DEBUG_OUTPUT_SYNCHRONOUS)
+	gl43.DebugMessageCallback(func(source, gtype, id, severity uint32, length int32, message string, userParam unsafe.Pointer) {
+		msg := Message{Source: source, Type: gtype, Severity: severity, ID: id, Text: message}
+		record(msg)
+		if severityRank(severity) < minSeverity {
+			return
+		}
+		logAttrs(msg)
+	}, nil)
+
+	return true
+}
+
+func logAttrs(msg Message) {
+	attrs := []any{"source", sourceString(msg.Source), "type", typeString(msg.Type), "id", msg.ID}
+	switch msg.Severity {
+	case gl43.DEBUG_SEVERITY_HIGH:
+		slog.Error("gl debug", append(attrs, "message", msg.Text)...)
+	case gl43.DEBUG_SEVERITY_MEDIUM:
+		slog.Warn("gl debug", append(attrs, "message", msg.Text)...)
+	default:
+		slog.Info("gl debug", append(attrs, "message", msg.Text)...)
+	}
+}
+
+func severityRank(severity uint32) Severity {
+	switch severity {
+	case gl43.DEBUG_SEVERITY_HIGH:
+		return SeverityHigh
+	case gl43.DEBUG_SEVERITY_MEDIUM:
+		return SeverityMedium
+	case gl43.DEBUG_SEVERITY_LOW:
+		return SeverityLow
+	default:
+		return SeverityNotification
+	}
+}
+
+func sourceString(source uint32) string {
+	switch source {
+	case gl43.DEBUG_SOURCE_API:
+		return "api"
+	case gl43.DEBUG_SOURCE_WINDOW_SYSTEM:
+		return "window_system"
+	case gl43.DEBUG_SOURCE_SHADER_COMPILER:
+		return "shader_compiler"
+	case gl43.DEBUG_SOURCE_THIRD_PARTY:
+		return "third_party"
+	case gl43.DEBUG_SOURCE_APPLICATION:
+		return "application"
+	default:
+		return "other"
+	}
+}
+
+func typeString(gtype uint32) string {
+	switch gtype {
+	case gl43.DEBUG_TYPE_ERROR:
+		return "error"
+	case gl43.DEBUG_TYPE_DEPRECATED_BEHAVIOR:
+		return "deprecated"
+	case gl43.DEBUG_TYPE_UNDEFINED_BEHAVIOR:
+		return "undefined_behavior"
+	case gl43.DEBUG_TYPE_PORTABILITY:
+		return "portability"
+	case gl43.DEBUG_TYPE_PERFORMANCE:
+		return "performance"
+	default:
+		return "other"
+	}
+}
+
+// hasExtension reports whether the current context exposes name.
+func hasExtension(name string) bool {
+	var count int32
+	gl.GetIntegerv(gl.NUM_EXTENSIONS, &count)
+	for i := int32(0); i < count; i++ {
+		if gl.GoStr(gl.GetStringi(gl.EXTENSIONS, uint32(i))) == name {
+			return true
+		}
+	}
+	return false
+}
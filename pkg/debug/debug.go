@@ -0,0 +1,94 @@
+// Package debug surfaces OpenGL errors that the original render loop
+// swallowed silently once past shader compilation. Where the context
+// supports GL_KHR_debug it registers a callback that routes driver
+// messages through log/slog; otherwise callers fall back to CheckError at
+// key points (after link, after buffer upload, after draw).
+package debug
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+)
+
+// Message is a single entry reported by the driver, either via the debug
+// callback or synthesized from glGetError by CheckError.
+type Message struct {
+	Source   uint32
+	Type     uint32
+	Severity uint32
+	ID       uint32
+	Label    string
+	Text     string
+}
+
+const ringSize = 256
+
+var (
+	mu   sync.Mutex
+	ring []Message
+)
+
+// record appends msg to the ring buffer, dropping the oldest entry once
+// ringSize is exceeded.
+func record(msg Message) {
+	mu.Lock()
+	defer mu.Unlock()
+	ring = append(ring, msg)
+	if len(ring) > ringSize {
+		ring = ring[len(ring)-ringSize:]
+	}
+}
+
+// LastMessages returns a copy of the most recent reported messages, oldest
+// first.
+func LastMessages() []Message {
+	mu.Lock()
+	defer mu.Unlock()
+	out := make([]Message, len(ring))
+	copy(out, ring)
+	return out
+}
+
+// CheckError wraps glGetError, logging and recording every pending error
+// under label so a caller knows roughly where it came from. It's meant to
+// be sprinkled after link, after buffer upload and after draw calls on
+// contexts without GL_KHR_debug support.
+func CheckError(label string) {
+	for {
+		code := gl.GetError()
+		if code == gl.NO_ERROR {
+			return
+		}
+		msg := Message{Type: errorTypeTag, Severity: severityHighTag, Label: label, Text: errString(code)}
+		record(msg)
+		slog.Error("gl error", "label", label, "code", msg.Text)
+	}
+}
+
+// errorTypeTag and severityHighTag stand in for the GL_DEBUG_TYPE_ERROR /
+// GL_DEBUG_SEVERITY_HIGH enums so CheckError messages are tagged
+// consistently with ones reported through the callback, without requiring
+// the 4.3 debug-output package just to reference two constants.
+const (
+	errorTypeTag    = 0x824C
+	severityHighTag = 0x9146
+)
+
+func errString(code uint32) string {
+	switch code {
+	case gl.INVALID_ENUM:
+		return "GL_INVALID_ENUM"
+	case gl.INVALID_VALUE:
+		return "GL_INVALID_VALUE"
+	case gl.INVALID_OPERATION:
+		return "GL_INVALID_OPERATION"
+	case gl.INVALID_FRAMEBUFFER_OPERATION:
+		return "GL_INVALID_FRAMEBUFFER_OPERATION"
+	case gl.OUT_OF_MEMORY:
+		return "GL_OUT_OF_MEMORY"
+	default:
+		return "GL_UNKNOWN_ERROR"
+	}
+}
@@ -0,0 +1,24 @@
+//go:build !gl41 && !gl46
+
+package glctx
+
+import gl "github.com/go-gl/gl/v3.3-core/gl"
+
+// bindingName identifies which go-gl version package this build was
+// compiled against; v3.3-core is the default when no gl41/gl46 build tag
+// is set.
+const bindingName = "v3.3-core"
+
+func initBinding() error { return gl.Init() }
+
+func getString(name uint32) string { return gl.GoStr(gl.GetString(name)) }
+
+func getIntegerv(name uint32) int32 {
+	var v int32
+	gl.GetIntegerv(name, &v)
+	return v
+}
+
+func getStringi(name uint32, index uint32) string {
+	return gl.GoStr(gl.GetStringi(name, index))
+}
@@ -0,0 +1,21 @@
+//go:build gl46
+
+package glctx
+
+import gl "github.com/go-gl/gl/v4.6-core/gl"
+
+const bindingName = "v4.6-core"
+
+func initBinding() error { return gl.Init() }
+
+func getString(name uint32) string { return gl.GoStr(gl.GetString(name)) }
+
+func getIntegerv(name uint32) int32 {
+	var v int32
+	gl.GetIntegerv(name, &v)
+	return v
+}
+
+func getStringi(name uint32, index uint32) string {
+	return gl.GoStr(gl.GetStringi(name, index))
+}
@@ -0,0 +1,81 @@
+package glctx
+
+import (
+	"fmt"
+
+	"github.com/go-gl/glfw/v3.3/glfw"
+)
+
+// Negotiate tries to create a window+context satisfying req, attempting
+// candidate GL versions from MaxVersion down to MinVersion and returning
+// the first one the driver accepts along with its reported Capabilities.
+func Negotiate(title string, width, height int, req Request) (*glfw.Window, Capabilities, error) {
+	if err := glfw.Init(); err != nil {
+		return nil, Capabilities{}, err
+	}
+
+	candidates := candidateVersions(req)
+	if len(candidates) == 0 {
+		return nil, Capabilities{}, fmt.Errorf("glctx: no known GL version in requested range %v-%v", req.MinVersion, req.MaxVersion)
+	}
+
+	var lastErr error
+	for _, v := range candidates {
+		glfw.WindowHint(glfw.ContextVersionMajor, v.major)
+		glfw.WindowHint(glfw.ContextVersionMinor, v.minor)
+		if req.Profile == ProfileCore {
+			glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+		} else {
+			glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCompatProfile)
+		}
+		glfw.WindowHint(glfw.OpenGLForwardCompatible, boolHint(req.ForwardCompatible))
+		glfw.WindowHint(glfw.OpenGLDebugContext, boolHint(req.Debug))
+		glfw.WindowHint(glfw.SRGBCapable, boolHint(req.SRGB))
+		glfw.WindowHint(glfw.Samples, req.Samples)
+
+		window, err := glfw.CreateWindow(width, height, title, nil, nil)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		window.MakeContextCurrent()
+		if err := initBinding(); err != nil {
+			window.Destroy()
+			lastErr = err
+			continue
+		}
+
+		return window, capabilities(v), nil
+	}
+
+	return nil, Capabilities{}, fmt.Errorf("glctx: no candidate GL version in range %v-%v could be created: %w", req.MinVersion, req.MaxVersion, lastErr)
+}
+
+func boolHint(b bool) int {
+	if b {
+		return glfw.True
+	}
+	return glfw.False
+}
+
+// capabilities reports what a just-created context of version v supports.
+func capabilities(v version) Capabilities {
+	caps := Capabilities{
+		Major:          v.major,
+		Minor:          v.minor,
+		Version:        getString(glVERSION),
+		Binding:        bindingName,
+		ExtensionCount: getIntegerv(glNUM_EXTENSIONS),
+	}
+
+	atLeast := func(major, minor int) bool {
+		return !v.less(version{major, minor})
+	}
+	caps.ComputeShaders = atLeast(4, 3)
+	caps.SSBO = atLeast(4, 3)
+	caps.DSA = atLeast(4, 5)
+	caps.BindlessTextures = hasExtension("GL_ARB_bindless_texture")
+
+	return caps
+}
@@ -0,0 +1,90 @@
+// Package glctx negotiates a GL context version/profile instead of
+// hard-coding ContextVersionMajor/Minor in initGlfw, and reports back what
+// the created context actually supports. Which go-gl binding package is
+// compiled in (v3.3-core, v4.1-core, v4.6-core, ...) is chosen by build
+// tag (see binding_*.go) so user code imports glctx instead of a specific
+// go-gl version path.
+package glctx
+
+// Profile selects the GL profile requested at context creation.
+type Profile int
+
+const (
+	ProfileCore Profile = iota
+	ProfileCompat
+)
+
+// version is a (major, minor) GL version pair.
+type version struct{ major, minor int }
+
+// Request describes the range of GL contexts a caller is willing to
+// accept. Negotiate tries versions from MaxVersion down to MinVersion,
+// returning the first one the driver accepts.
+type Request struct {
+	MinVersion, MaxVersion [2]int
+	Profile                Profile
+	ForwardCompatible      bool
+	Debug                  bool
+	SRGB                   bool
+	Samples                int // MSAA sample count, 0 disables multisampling
+}
+
+// Capabilities describes a successfully created context.
+type Capabilities struct {
+	Major, Minor int
+	Version      string
+	Binding      string // which go-gl version package was compiled in, e.g. "v4.1-core"
+
+	ExtensionCount int32
+
+	SSBO             bool // shader storage buffer objects (core since 4.3)
+	ComputeShaders   bool // core since 4.3
+	BindlessTextures bool // GL_ARB_bindless_texture
+	DSA              bool // direct state access, core since 4.5
+}
+
+// candidateVersions returns the known GL versions between
+// req.MinVersion and req.MaxVersion inclusive, from highest to lowest.
+func candidateVersions(req Request) []version {
+	known := []version{
+		{4, 6}, {4, 5}, {4, 4}, {4, 3}, {4, 2}, {4, 1}, {4, 0},
+		{3, 3}, {3, 2}, {3, 1}, {3, 0},
+	}
+	min, max := version{req.MinVersion[0], req.MinVersion[1]}, version{req.MaxVersion[0], req.MaxVersion[1]}
+
+	var out []version
+	for _, v := range known {
+		if v.less(min) || max.less(v) {
+			continue
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+func (v version) less(other version) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	return v.minor < other.minor
+}
+
+// GL enum values used by Negotiate/capabilities. These are the same
+// numeric constants across every core GL version, so they're declared
+// once here rather than per binding_*.go file.
+const (
+	glVERSION        = 0x1F02
+	glNUM_EXTENSIONS = 0x821D
+	glEXTENSIONS     = 0x1F03
+)
+
+// hasExtension reports whether the current context exposes name.
+func hasExtension(name string) bool {
+	count := getIntegerv(glNUM_EXTENSIONS)
+	for i := int32(0); i < count; i++ {
+		if getStringi(glEXTENSIONS, uint32(i)) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package glctx
+
+import "testing"
+
+func TestVersionLess(t *testing.T) {
+	cases := []struct {
+		a, b version
+		want bool
+	}{
+		{version{3, 3}, version{4, 1}, true},
+		{version{4, 1}, version{3, 3}, false},
+		{version{4, 1}, version{4, 3}, true},
+		{version{4, 3}, version{4, 1}, false},
+		{version{4, 1}, version{4, 1}, false},
+	}
+	for _, c := range cases {
+		if got := c.a.less(c.b); got != c.want {
+			t.Errorf("%v.less(%v) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestCandidateVersionsDescendingWithinRange(t *testing.T) {
+	req := Request{MinVersion: [2]int{3, 3}, MaxVersion: [2]int{4, 3}}
+	got := candidateVersions(req)
+
+	want := []version{{4, 3}, {4, 2}, {4, 1}, {4, 0}, {3, 3}}
+	if len(got) != len(want) {
+		t.Fatalf("candidateVersions() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("candidateVersions()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCandidateVersionsEmptyRange(t *testing.T) {
+	req := Request{MinVersion: [2]int{5, 0}, MaxVersion: [2]int{5, 1}}
+	if got := candidateVersions(req); len(got) != 0 {
+		t.Errorf("candidateVersions() = %v, want empty", got)
+	}
+}
@@ -0,0 +1,83 @@
+// Package glthread owns the OS thread an OpenGL context is bound to and
+// lets other goroutines schedule work onto it. GL contexts are bound to a
+// single OS thread (hence runtime.LockOSThread in main), which otherwise
+// makes it unsafe to call GL from asset loaders, network handlers or any
+// other goroutine.
+package glthread
+
+import (
+	"fmt"
+	"runtime"
+)
+
+var (
+	queue  chan func()
+	glGID  int64
+	inited bool
+)
+
+// Init locks the calling goroutine to its OS thread and marks it as the GL
+// thread. It must be called once, early in main, before any GL calls are
+// made or any work is scheduled via Do/DoSync.
+func Init(bufSize int) {
+	runtime.LockOSThread()
+	queue = make(chan func(), bufSize)
+	glGID = goroutineID()
+	inited = true
+}
+
+// Do schedules fn to run on the GL thread and returns without waiting for
+// it to execute. It blocks only while the queue's buffer is full.
+func Do(fn func()) {
+	queue <- fn
+}
+
+// DoSync schedules fn to run on the GL thread and blocks until it has run,
+// returning its error.
+func DoSync(fn func() error) error {
+	done := make(chan error, 1)
+	queue <- func() { done <- fn() }
+	return <-done
+}
+
+// Drain runs every function currently queued, without blocking for more to
+// arrive. Call it from the render loop, between PollEvents and
+// SwapBuffers, so queued GL work lands between frames.
+func Drain() {
+	for {
+		select {
+		case fn := <-queue:
+			fn()
+		default:
+			return
+		}
+	}
+}
+
+// MustBeGLThread panics if called from a goroutine other than the one that
+// called Init. It's a cheap debug assertion meant to catch accidental
+// off-thread GL calls close to where they happen, rather than as a
+// mysterious driver crash or silent no-op later.
+func MustBeGLThread() {
+	if !inited {
+		panic("glthread: MustBeGLThread called before Init")
+	}
+	if gid := goroutineID(); gid != glGID {
+		panic(fmt.Sprintf("glthread: GL call from goroutine %d, want GL thread %d", gid, glGID))
+	}
+}
+
+// goroutineID extracts the calling goroutine's id from its stack trace.
+// This is the well-known (if officially unsupported) trick for obtaining a
+// goroutine id in Go; it's only used here for a debug assertion, never for
+// control flow that affects program behavior.
+func goroutineID() int64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	var id int64
+	_, err := fmt.Sscanf(string(buf), "goroutine %d ", &id)
+	if err != nil {
+		return -1
+	}
+	return id
+}
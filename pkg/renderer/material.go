@@ -0,0 +1,41 @@
+package renderer
+
+import "github.com/go-gl/gl/v3.3-core/gl"
+
+// Material binds a shader program and the uniform values a Mesh is drawn
+// with. It is intentionally minimal for now; typed setters and #include
+// support land with the shader package.
+type Material struct {
+	Program uint32
+	Color   [4]float32
+
+	// ColorUniform is the name of the vec4 uniform Color is uploaded to.
+	ColorUniform string
+
+	colorLoc    int32
+	colorLocSet bool
+}
+
+// NewMaterial returns a Material bound to program, uploading color to the
+// uniform named colorUniform.
+func NewMaterial(program uint32, colorUniform string, color [4]float32) *Material {
+	return &Material{Program: program, Color: color, ColorUniform: colorUniform}
+}
+
+// Bind activates the material's program and uploads its uniforms. modelLoc
+// is the cached "model" uniform location, or -1 if the program has none.
+func (mat *Material) Bind(modelLoc int32, model *[16]float32) {
+	gl.UseProgram(mat.Program)
+
+	if !mat.colorLocSet {
+		mat.colorLoc = gl.GetUniformLocation(mat.Program, gl.Str(mat.ColorUniform+"\x00"))
+		mat.colorLocSet = true
+	}
+	if mat.colorLoc != -1 {
+		gl.Uniform4f(mat.colorLoc, mat.Color[0], mat.Color[1], mat.Color[2], mat.Color[3])
+	}
+
+	if modelLoc != -1 {
+		gl.UniformMatrix4fv(modelLoc, 1, false, &model[0])
+	}
+}
@@ -0,0 +1,75 @@
+package renderer
+
+import "github.com/go-gl/gl/v3.3-core/gl"
+
+// Usage maps to a GL buffer usage hint (STATIC/DYNAMIC/STREAM_DRAW).
+type Usage uint32
+
+// Buffer usage hints, mirroring the GL_*_DRAW enums.
+const (
+	StaticDraw  Usage = gl.STATIC_DRAW
+	DynamicDraw Usage = gl.DYNAMIC_DRAW
+	StreamDraw  Usage = gl.STREAM_DRAW
+)
+
+// Mesh is an indexed GPU geometry buffer: a VAO bound to a vertex VBO and,
+// when indices are provided, an EBO drawn with gl.DrawElements. This
+// replaces the old makeVao helper, which only supported gl.DrawArrays and
+// required vertices to be duplicated for shared geometry.
+type Mesh struct {
+	vao, vbo, ebo uint32
+	layout        VertexLayout
+	vertexCount   int32
+	indexCount    int32
+}
+
+// NewMesh uploads vertices (and, if non-empty, indices) to the GPU and
+// returns a Mesh ready to Draw. usage controls how the driver is hinted to
+// treat the vertex buffer; the index buffer is uploaded with the same
+// usage hint.
+func NewMesh(vertices []float32, indices []uint32, layout VertexLayout, usage Usage) *Mesh {
+	m := &Mesh{
+		layout:      layout,
+		vertexCount: int32(len(vertices)) / (layout.Stride() / 4),
+	}
+
+	gl.GenVertexArrays(1, &m.vao)
+	gl.BindVertexArray(m.vao)
+
+	gl.GenBuffers(1, &m.vbo)
+	gl.BindBuffer(gl.ARRAY_BUFFER, m.vbo)
+	gl.BufferData(gl.ARRAY_BUFFER, 4*len(vertices), gl.Ptr(vertices), uint32(usage))
+	layout.Enable()
+
+	if len(indices) > 0 {
+		gl.GenBuffers(1, &m.ebo)
+		gl.BindBuffer(gl.ELEMENT_ARRAY_BUFFER, m.ebo)
+		gl.BufferData(gl.ELEMENT_ARRAY_BUFFER, 4*len(indices), gl.Ptr(indices), uint32(usage))
+		m.indexCount = int32(len(indices))
+	}
+
+	gl.BindVertexArray(0)
+	return m
+}
+
+// Draw binds the mesh's VAO and issues a single draw call, using
+// gl.DrawElements when the mesh has an EBO and falling back to
+// gl.DrawArrays otherwise.
+func (m *Mesh) Draw() {
+	gl.BindVertexArray(m.vao)
+	if m.indexCount > 0 {
+		gl.DrawElements(gl.TRIANGLES, m.indexCount, gl.UNSIGNED_INT, gl.PtrOffset(0))
+		return
+	}
+	gl.DrawArrays(gl.TRIANGLES, 0, m.vertexCount)
+}
+
+// Delete releases the mesh's GPU buffers. Callers are responsible for
+// calling it on the GL thread once the mesh is no longer in use.
+func (m *Mesh) Delete() {
+	gl.DeleteVertexArrays(1, &m.vao)
+	gl.DeleteBuffers(1, &m.vbo)
+	if m.ebo != 0 {
+		gl.DeleteBuffers(1, &m.ebo)
+	}
+}
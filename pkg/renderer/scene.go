@@ -0,0 +1,70 @@
+package renderer
+
+import "github.com/go-gl/gl/v3.3-core/gl"
+
+// Identity4 returns a column-major 4x4 identity matrix, the default
+// Transform for a freshly created Node.
+func Identity4() [16]float32 {
+	return [16]float32{
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// Node is a single entry in a Scene: a Mesh drawn with a Material at a
+// given Transform.
+type Node struct {
+	Mesh      *Mesh
+	Material  *Material
+	Transform [16]float32
+}
+
+// NewNode returns a Node at the identity transform.
+func NewNode(mesh *Mesh, mat *Material) *Node {
+	return &Node{Mesh: mesh, Material: mat, Transform: Identity4()}
+}
+
+// Scene holds the set of nodes a Renderer draws each frame.
+type Scene struct {
+	Nodes []*Node
+}
+
+// NewScene returns an empty Scene.
+func NewScene() *Scene {
+	return &Scene{}
+}
+
+// Add appends node to the scene.
+func (s *Scene) Add(node *Node) {
+	s.Nodes = append(s.Nodes, node)
+}
+
+// Renderer draws a Scene. It caches each material program's "model"
+// uniform location across frames to avoid re-querying it on every draw.
+type Renderer struct {
+	Scene *Scene
+
+	modelLocs map[uint32]int32
+}
+
+// NewRenderer returns a Renderer that draws scene on Render.
+func NewRenderer(scene *Scene) *Renderer {
+	return &Renderer{Scene: scene, modelLocs: make(map[uint32]int32)}
+}
+
+// Render draws every node in the scene with its bound material, in
+// insertion order. Callers are expected to have cleared the framebuffer
+// and to call SwapBuffers themselves.
+func (r *Renderer) Render() {
+	for _, n := range r.Scene.Nodes {
+		loc, ok := r.modelLocs[n.Material.Program]
+		if !ok {
+			loc = gl.GetUniformLocation(n.Material.Program, gl.Str("model\x00"))
+			r.modelLocs[n.Material.Program] = loc
+		}
+		n.Material.Bind(loc, &n.Transform)
+		n.Mesh.Draw()
+	}
+}
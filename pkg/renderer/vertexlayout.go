@@ -0,0 +1,81 @@
+package renderer
+
+import "github.com/go-gl/gl/v3.3-core/gl"
+
+// Attribute describes a single vertex attribute within a VertexLayout, e.g.
+// position, normal, color or uv.
+type Attribute struct {
+	Name       string
+	Location   uint32
+	Size       int32 // number of components (e.g. 3 for vec3)
+	Type       uint32
+	Normalized bool
+}
+
+// componentSize returns the size in bytes of a single component of the
+// attribute's Type. Only the GL types used by vertex data are supported.
+func (a Attribute) componentSize() int32 {
+	switch a.Type {
+	case gl.FLOAT:
+		return 4
+	case gl.UNSIGNED_BYTE, gl.BYTE:
+		return 1
+	case gl.UNSIGNED_SHORT, gl.SHORT:
+		return 2
+	default:
+		return 4
+	}
+}
+
+// VertexLayout describes how a Mesh's vertex buffer is packed, computing
+// stride and per-attribute offsets so callers don't hand-compute them.
+type VertexLayout struct {
+	Attributes []Attribute
+}
+
+// NewVertexLayout builds a layout from attrs. Callers must set each
+// Attribute's Location explicitly (including 0): a layout can't safely
+// guess at locations, since two attributes are allowed to share one (e.g.
+// via glVertexAttribDivisor-style packing) and an author may deliberately
+// pin a non-first attribute to location 0.
+func NewVertexLayout(attrs ...Attribute) VertexLayout {
+	return VertexLayout{Attributes: attrs}
+}
+
+// Stride returns the total size in bytes of one vertex as described by the
+// layout.
+func (l VertexLayout) Stride() int32 {
+	var stride int32
+	for _, a := range l.Attributes {
+		stride += a.Size * a.componentSize()
+	}
+	return stride
+}
+
+// Offset returns the byte offset of the attribute at index i within a single
+// vertex.
+func (l VertexLayout) Offset(i int) int {
+	var offset int32
+	for j := 0; j < i; j++ {
+		a := l.Attributes[j]
+		offset += a.Size * a.componentSize()
+	}
+	return int(offset)
+}
+
+// Enable binds the layout's attributes to the currently bound VBO/VAO.
+func (l VertexLayout) Enable() {
+	stride := l.Stride()
+	for i, a := range l.Attributes {
+		gl.EnableVertexAttribArray(a.Location)
+		gl.VertexAttribPointer(a.Location, a.Size, a.Type, a.Normalized, stride, gl.PtrOffset(l.Offset(i)))
+	}
+}
+
+// Common attribute names used by the built-in layouts and loaders.
+const (
+	AttribPosition = "position"
+	AttribNormal   = "normal"
+	AttribColor    = "color"
+	AttribUV       = "uv"
+)
@@ -0,0 +1,39 @@
+package renderer
+
+import (
+	"testing"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+)
+
+func TestVertexLayoutStrideAndOffset(t *testing.T) {
+	layout := NewVertexLayout(
+		Attribute{Name: AttribPosition, Location: 0, Size: 3, Type: gl.FLOAT},
+		Attribute{Name: AttribUV, Location: 1, Size: 2, Type: gl.FLOAT},
+	)
+
+	if got, want := layout.Stride(), int32(5*4); got != want {
+		t.Errorf("Stride() = %d, want %d", got, want)
+	}
+	if got, want := layout.Offset(0), 0; got != want {
+		t.Errorf("Offset(0) = %d, want %d", got, want)
+	}
+	if got, want := layout.Offset(1), 3*4; got != want {
+		t.Errorf("Offset(1) = %d, want %d", got, want)
+	}
+}
+
+func TestNewVertexLayoutPreservesExplicitLocations(t *testing.T) {
+	// Two attributes deliberately pinned to the same location (e.g. an
+	// interleaved/aliased attribute) must round-trip unchanged.
+	layout := NewVertexLayout(
+		Attribute{Name: "a", Location: 0, Size: 1, Type: gl.FLOAT},
+		Attribute{Name: "b", Location: 0, Size: 1, Type: gl.FLOAT},
+	)
+
+	for i, a := range layout.Attributes {
+		if a.Location != 0 {
+			t.Errorf("Attributes[%d].Location = %d, want 0 (unchanged)", i, a.Location)
+		}
+	}
+}
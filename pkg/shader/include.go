@@ -0,0 +1,64 @@
+package shader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// resolveIncludes expands `#include "path"` directives found in source,
+// resolving each path relative to dir (the directory of the file source
+// came from). Includes are expanded recursively so an included file may
+// itself include others; seen guards against cycles.
+func resolveIncludes(source, dir string, seen map[string]bool) (string, error) {
+	lines := strings.Split(source, "\n")
+	var out strings.Builder
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "#include") {
+			out.WriteString(line)
+			out.WriteByte('\n')
+			continue
+		}
+
+		start := strings.IndexByte(trimmed, '"')
+		end := strings.LastIndexByte(trimmed, '"')
+		if start == -1 || end == -1 || start == end {
+			return "", fmt.Errorf("shader: malformed #include directive: %q", trimmed)
+		}
+		incPath := filepath.Join(dir, trimmed[start+1:end])
+
+		absPath, err := filepath.Abs(incPath)
+		if err != nil {
+			return "", err
+		}
+		if seen[absPath] {
+			continue
+		}
+		seen[absPath] = true
+
+		incSource, err := os.ReadFile(incPath)
+		if err != nil {
+			return "", fmt.Errorf("shader: #include %q: %w", incPath, err)
+		}
+		expanded, err := resolveIncludes(string(incSource), filepath.Dir(incPath), seen)
+		if err != nil {
+			return "", err
+		}
+		out.WriteString(expanded)
+	}
+
+	return out.String(), nil
+}
+
+// loadSource reads path and expands any #include directives found within
+// it, relative to path's own directory.
+func loadSource(path string) (string, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return resolveIncludes(string(raw), filepath.Dir(path), map[string]bool{})
+}
@@ -0,0 +1,59 @@
+package shader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestResolveIncludesExpandsFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "common.glsl", "vec4 tint = vec4(1.0);\n")
+	main := "#version 330\n#include \"common.glsl\"\nvoid main() {}\n"
+
+	got, err := resolveIncludes(main, dir, map[string]bool{})
+	if err != nil {
+		t.Fatalf("resolveIncludes: %v", err)
+	}
+
+	want := "#version 330\nvec4 tint = vec4(1.0);\n\nvoid main() {}\n\n"
+	if got != want {
+		t.Errorf("resolveIncludes() = %q, want %q", got, want)
+	}
+}
+
+func TestResolveIncludesCycleDoesNotRecurseForever(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "a.glsl", "#include \"b.glsl\"\n")
+	writeFile(t, dir, "b.glsl", "#include \"a.glsl\"\n")
+
+	done := make(chan struct{})
+	var err error
+	go func() {
+		_, err = resolveIncludes("#include \"a.glsl\"\n", dir, map[string]bool{})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		if err != nil {
+			t.Fatalf("resolveIncludes: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("resolveIncludes did not return: likely infinite recursion on an include cycle")
+	}
+}
+
+func TestResolveIncludesMalformedDirective(t *testing.T) {
+	if _, err := resolveIncludes("#include broken\n", t.TempDir(), map[string]bool{}); err == nil {
+		t.Fatal("expected an error for a malformed #include directive, got nil")
+	}
+}
+
+func writeFile(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+		t.Fatalf("writeFile(%s): %v", name, err)
+	}
+}
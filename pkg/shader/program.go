@@ -0,0 +1,113 @@
+package shader
+
+import (
+	"log"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+)
+
+// Program is a linked GL shader program that remembers the files it was
+// built from (so it can be reloaded) and caches uniform locations so hot
+// render-loop paths don't call gl.GetUniformLocation every frame.
+type Program struct {
+	ID uint32
+
+	vertPath, fragPath string
+	uniforms           map[string]int32
+	warned             map[string]bool
+	reloadCh           chan reloadCmd
+}
+
+// Load reads, compiles and links the shader at vertPath/fragPath,
+// expanding any #include directives relative to each file's directory.
+func Load(vertPath, fragPath string) (*Program, error) {
+	vertSrc, err := loadSource(vertPath)
+	if err != nil {
+		return nil, err
+	}
+	fragSrc, err := loadSource(fragPath)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := buildProgram(vertSrc, fragSrc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Program{
+		ID:       id,
+		vertPath: vertPath,
+		fragPath: fragPath,
+		uniforms: make(map[string]int32),
+		warned:   make(map[string]bool),
+	}, nil
+}
+
+// buildProgram compiles and links a vertex/fragment source pair into a
+// fresh program.
+func buildProgram(vertSrc, fragSrc string) (uint32, error) {
+	vertex, err := compile(vertSrc, gl.VERTEX_SHADER)
+	if err != nil {
+		return 0, err
+	}
+	fragment, err := compile(fragSrc, gl.FRAGMENT_SHADER)
+	if err != nil {
+		gl.DeleteShader(vertex)
+		return 0, err
+	}
+	return link(vertex, fragment)
+}
+
+// Use activates the program for subsequent draw calls.
+func (p *Program) Use() {
+	gl.UseProgram(p.ID)
+}
+
+// location returns the cached uniform location for name, querying and
+// caching it on first use. A location of -1 (uniform not found, or
+// optimized out by the compiler) is logged once per name rather than on
+// every call.
+func (p *Program) location(name string) int32 {
+	if loc, ok := p.uniforms[name]; ok {
+		return loc
+	}
+	loc := gl.GetUniformLocation(p.ID, gl.Str(name+"\x00"))
+	p.uniforms[name] = loc
+	if loc == -1 && !p.warned[name] {
+		log.Printf("shader: uniform %q not found in program (vert=%s frag=%s)", name, p.vertPath, p.fragPath)
+		p.warned[name] = true
+	}
+	return loc
+}
+
+// SetVec4 uploads a vec4 uniform.
+func (p *Program) SetVec4(name string, v [4]float32) {
+	if loc := p.location(name); loc != -1 {
+		gl.Uniform4f(loc, v[0], v[1], v[2], v[3])
+	}
+}
+
+// SetMat4 uploads a column-major mat4 uniform.
+func (p *Program) SetMat4(name string, m *[16]float32) {
+	if loc := p.location(name); loc != -1 {
+		gl.UniformMatrix4fv(loc, 1, false, &m[0])
+	}
+}
+
+// SetInt uploads an int/sampler uniform.
+func (p *Program) SetInt(name string, v int32) {
+	if loc := p.location(name); loc != -1 {
+		gl.Uniform1i(loc, v)
+	}
+}
+
+// Delete releases the underlying GL program. Must be called on the GL
+// thread.
+func (p *Program) Delete() {
+	deleteProgram(p.ID)
+}
+
+func deleteProgram(id uint32) {
+	gl.DeleteProgram(id)
+}
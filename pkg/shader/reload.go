@@ -0,0 +1,90 @@
+package shader
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+)
+
+// reloadCmd is sent from the polling goroutine to the GL thread; it never
+// touches GL itself; applying it is left to Poll.
+type reloadCmd struct{}
+
+// WatchAndReload polls vertPath/fragPath for mtime changes every interval
+// and, on a change, queues a reload. The actual recompile happens on
+// whichever goroutine calls Poll, since GL calls must stay on the GL
+// thread; WatchAndReload itself never touches GL.
+func (p *Program) WatchAndReload(ctx context.Context, interval time.Duration) {
+	if p.reloadCh == nil {
+		p.reloadCh = make(chan reloadCmd, 1)
+	}
+
+	go func() {
+		vertMod, fragMod := statTime(p.vertPath), statTime(p.fragPath)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				v, f := statTime(p.vertPath), statTime(p.fragPath)
+				if v.After(vertMod) || f.After(fragMod) {
+					vertMod, fragMod = v, f
+					select {
+					case p.reloadCh <- reloadCmd{}:
+					default:
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Poll checks for a pending reload queued by WatchAndReload and, if one is
+// present, recompiles the program from disk and atomically swaps its ID.
+// On a compile or link error the previous program keeps running and the
+// error is logged. Poll must be called from the GL thread.
+func (p *Program) Poll() {
+	if p.reloadCh == nil {
+		return
+	}
+	select {
+	case <-p.reloadCh:
+	default:
+		return
+	}
+
+	vertSrc, err := loadSource(p.vertPath)
+	if err != nil {
+		log.Printf("shader: reload %s: %v", p.vertPath, err)
+		return
+	}
+	fragSrc, err := loadSource(p.fragPath)
+	if err != nil {
+		log.Printf("shader: reload %s: %v", p.fragPath, err)
+		return
+	}
+
+	newID, err := buildProgram(vertSrc, fragSrc)
+	if err != nil {
+		log.Printf("shader: reload failed, keeping previous program: %v", err)
+		return
+	}
+
+	oldID := p.ID
+	p.ID = newID
+	p.uniforms = make(map[string]int32)
+	p.warned = make(map[string]bool)
+	deleteProgram(oldID)
+}
+
+func statTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
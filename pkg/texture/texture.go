@@ -0,0 +1,152 @@
+// Package texture wraps GL texture object creation and decodes images via
+// Go's standard image/png and image/jpeg decoders.
+package texture
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+)
+
+// textureMaxAnisotropyEXT is GL_TEXTURE_MAX_ANISOTROPY_EXT, not exposed by
+// the core gl package since anisotropic filtering is still an extension
+// (GL_EXT_texture_filter_anisotropic) rather than core GL.
+const textureMaxAnisotropyEXT = 0x84FE
+
+// Options configures how a Texture is uploaded and sampled.
+type Options struct {
+	WrapS, WrapT         int32
+	MinFilter, MagFilter int32
+	SRGB                 bool // use GL_SRGB8_ALPHA8 as the internal format
+	GenerateMipmaps      bool
+	Anisotropy           float32 // requested max anisotropy; 0 disables it
+}
+
+// DefaultOptions returns repeat wrapping, linear-mipmap-linear filtering
+// and mipmap generation enabled, which is a reasonable default for most
+// diffuse textures.
+func DefaultOptions() Options {
+	return Options{
+		WrapS:           gl.REPEAT,
+		WrapT:           gl.REPEAT,
+		MinFilter:       gl.LINEAR_MIPMAP_LINEAR,
+		MagFilter:       gl.LINEAR,
+		GenerateMipmaps: true,
+	}
+}
+
+// Texture is a 2D GL texture object.
+type Texture struct {
+	ID            uint32
+	Width, Height int32
+}
+
+// LoadFromFile decodes the image at path (PNG or JPEG) and uploads it as a
+// texture.
+func LoadFromFile(path string, opts Options) (*Texture, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("texture: %w", err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("texture: decode %s: %w", path, err)
+	}
+	return FromImage(img, opts)
+}
+
+// FromImage uploads img as a texture. Images are converted to RGBA before
+// upload since that's the only format glTexImage2D is given below.
+func FromImage(img image.Image, opts Options) (*Texture, error) {
+	rgba := toRGBA(img)
+	flipVertical(rgba)
+
+	internalFormat := int32(gl.RGBA8)
+	if opts.SRGB {
+		internalFormat = gl.SRGB8_ALPHA8
+	}
+
+	var id uint32
+	gl.GenTextures(1, &id)
+	gl.BindTexture(gl.TEXTURE_2D, id)
+
+	gl.TexImage2D(
+		gl.TEXTURE_2D, 0, internalFormat,
+		int32(rgba.Rect.Dx()), int32(rgba.Rect.Dy()), 0,
+		gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(rgba.Pix),
+	)
+
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, opts.WrapS)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, opts.WrapT)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, opts.MinFilter)
+	gl.TexParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, opts.MagFilter)
+
+	if opts.Anisotropy > 0 && hasExtension("GL_EXT_texture_filter_anisotropic") {
+		var maxAniso float32
+		gl.GetFloatv(textureMaxAnisotropyEXT, &maxAniso)
+		if opts.Anisotropy > maxAniso {
+			opts.Anisotropy = maxAniso
+		}
+		gl.TexParameterf(gl.TEXTURE_2D, textureMaxAnisotropyEXT, opts.Anisotropy)
+	}
+
+	if opts.GenerateMipmaps {
+		gl.GenerateMipmap(gl.TEXTURE_2D)
+	}
+
+	gl.BindTexture(gl.TEXTURE_2D, 0)
+
+	return &Texture{ID: id, Width: int32(rgba.Rect.Dx()), Height: int32(rgba.Rect.Dy())}, nil
+}
+
+// toRGBA returns an *image.RGBA with img's contents, always a fresh copy
+// so callers can keep using img afterwards. FromImage flips the result in
+// place to upload it GL-side-up, which must not corrupt the caller's own
+// image even when img already happens to be an *image.RGBA.
+func toRGBA(img image.Image) *image.RGBA {
+	rgba := image.NewRGBA(img.Bounds())
+	draw.Draw(rgba, rgba.Bounds(), img, img.Bounds().Min, draw.Src)
+	return rgba
+}
+
+// flipVertical reverses rgba's rows in place. Go's image origin is
+// top-left while GL texture coordinates have their origin at the
+// bottom-left, so an unflipped upload renders upside down.
+func flipVertical(rgba *image.RGBA) {
+	height := rgba.Rect.Dy()
+	stride := rgba.Stride
+	row := make([]uint8, stride)
+	for y := 0; y < height/2; y++ {
+		top := rgba.Pix[y*stride : y*stride+stride]
+		bottom := rgba.Pix[(height-1-y)*stride : (height-1-y)*stride+stride]
+		copy(row, top)
+		copy(top, bottom)
+		copy(bottom, row)
+	}
+}
+
+// Delete releases the underlying GL texture object.
+func (t *Texture) Delete() {
+	gl.DeleteTextures(1, &t.ID)
+}
+
+// hasExtension reports whether the current context exposes name, looking
+// it up via glGetStringi since GL_EXTENSIONS is not queryable through
+// glGetString in a core profile.
+func hasExtension(name string) bool {
+	var count int32
+	gl.GetIntegerv(gl.NUM_EXTENSIONS, &count)
+	for i := int32(0); i < count; i++ {
+		if gl.GoStr(gl.GetStringi(gl.EXTENSIONS, uint32(i))) == name {
+			return true
+		}
+	}
+	return false
+}
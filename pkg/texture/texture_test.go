@@ -0,0 +1,58 @@
+package texture
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestFlipVertical(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.RGBA{R: 1, A: 255}) // top-left
+	img.Set(0, 1, color.RGBA{R: 2, A: 255}) // bottom-left
+
+	flipVertical(img)
+
+	if got := img.RGBAAt(0, 0).R; got != 2 {
+		t.Errorf("row 0 after flip: R = %d, want 2 (old bottom row)", got)
+	}
+	if got := img.RGBAAt(0, 1).R; got != 1 {
+		t.Errorf("row 1 after flip: R = %d, want 1 (old top row)", got)
+	}
+}
+
+func TestToRGBADoesNotAliasAnRGBAInput(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1, 2))
+	img.Set(0, 0, color.RGBA{R: 1, A: 255})
+	img.Set(0, 1, color.RGBA{R: 2, A: 255})
+
+	out := toRGBA(img)
+	if out == img {
+		t.Fatal("toRGBA returned the caller's *image.RGBA unchanged, instead of a copy")
+	}
+
+	flipVertical(out)
+
+	if got := img.RGBAAt(0, 0).R; got != 1 {
+		t.Errorf("caller's image row 0 mutated by flipping the copy: R = %d, want 1", got)
+	}
+	if got := img.RGBAAt(0, 1).R; got != 2 {
+		t.Errorf("caller's image row 1 mutated by flipping the copy: R = %d, want 2", got)
+	}
+}
+
+func TestFlipVerticalOddHeight(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1, 3))
+	img.Set(0, 0, color.RGBA{R: 1, A: 255})
+	img.Set(0, 1, color.RGBA{R: 2, A: 255})
+	img.Set(0, 2, color.RGBA{R: 3, A: 255})
+
+	flipVertical(img)
+
+	want := []uint8{3, 2, 1}
+	for y, w := range want {
+		if got := img.RGBAAt(0, y).R; got != w {
+			t.Errorf("row %d after flip: R = %d, want %d", y, got, w)
+		}
+	}
+}
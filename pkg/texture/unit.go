@@ -0,0 +1,44 @@
+package texture
+
+import (
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/umutbalkan/golang-gl/pkg/shader"
+)
+
+// Binder tracks which texture unit each bound Texture occupies, so callers
+// don't have to hand-manage gl.ActiveTexture/TEXTURE0+n bookkeeping
+// themselves.
+type Binder struct {
+	units map[*Texture]uint32
+	next  uint32
+}
+
+// NewBinder returns an empty Binder.
+func NewBinder() *Binder {
+	return &Binder{units: make(map[*Texture]uint32)}
+}
+
+// Bind assigns tex a texture unit (reusing its existing one if already
+// bound), binds it there and sets uniform on prog to that unit.
+func (b *Binder) Bind(tex *Texture, prog *shader.Program, uniform string) uint32 {
+	unit, ok := b.units[tex]
+	if !ok {
+		unit = b.next
+		b.units[tex] = unit
+		b.next++
+	}
+
+	gl.ActiveTexture(gl.TEXTURE0 + unit)
+	gl.BindTexture(gl.TEXTURE_2D, tex.ID)
+	prog.SetInt(uniform, int32(unit))
+
+	return unit
+}
+
+// Reset forgets all unit assignments, allowing them to be reused from
+// TEXTURE0 on the next Bind. Call it once per frame if the set of bound
+// textures changes frequently.
+func (b *Binder) Reset() {
+	b.units = make(map[*Texture]uint32)
+	b.next = 0
+}
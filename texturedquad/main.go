@@ -0,0 +1,122 @@
+// Command texturedquad replaces the hard-coded vertex-color triangle from
+// the triangle example with a textured quad, exercising the new shader,
+// renderer and texture packages together: indexed geometry via EBO, GLSL
+// loaded from files, and a generated checkerboard image uploaded as a
+// GL_RGBA8 texture.
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"runtime"
+
+	"github.com/go-gl/gl/v3.3-core/gl"
+	"github.com/go-gl/glfw/v3.3/glfw"
+
+	"github.com/umutbalkan/golang-gl/pkg/renderer"
+	"github.com/umutbalkan/golang-gl/pkg/shader"
+	"github.com/umutbalkan/golang-gl/pkg/texture"
+)
+
+const (
+	width  = 800
+	height = 600
+)
+
+var quadVertices = []float32{
+	// position          // uv
+	-0.5, 0.5, 0, 0, 1,
+	-0.5, -0.5, 0, 0, 0,
+	0.5, -0.5, 0, 1, 0,
+	0.5, 0.5, 0, 1, 1,
+}
+
+var quadIndices = []uint32{
+	0, 1, 2,
+	0, 2, 3,
+}
+
+func initGlfw() *glfw.Window {
+	if err := glfw.Init(); err != nil {
+		panic(err)
+	}
+	glfw.WindowHint(glfw.Resizable, glfw.False)
+	glfw.WindowHint(glfw.ContextVersionMajor, 4)
+	glfw.WindowHint(glfw.ContextVersionMinor, 1)
+	glfw.WindowHint(glfw.OpenGLProfile, glfw.OpenGLCoreProfile)
+	glfw.WindowHint(glfw.OpenGLForwardCompatible, glfw.True)
+
+	window, err := glfw.CreateWindow(width, height, "textured-quad", nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	window.MakeContextCurrent()
+
+	return window
+}
+
+// checkerboard returns a small black/white checkerboard image, used in
+// place of a bundled asset file so the example stays self-contained.
+func checkerboard(size, cell int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if (x/cell+y/cell)%2 == 0 {
+				img.Set(x, y, color.White)
+			} else {
+				img.Set(x, y, color.Black)
+			}
+		}
+	}
+	return img
+}
+
+func main() {
+	runtime.LockOSThread()
+	fmt.Printf("OS: %s, Architecture: %s\n", runtime.GOOS, runtime.GOARCH)
+
+	window := initGlfw()
+	defer glfw.Terminate()
+
+	if err := gl.Init(); err != nil {
+		panic(err)
+	}
+	log.Println("OpenGL version", gl.GoStr(gl.GetString(gl.VERSION)))
+
+	prog, err := shader.Load("texturedquad/shaders/quad.vert", "texturedquad/shaders/quad.frag")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	layout := renderer.NewVertexLayout(
+		renderer.Attribute{Name: renderer.AttribPosition, Location: 0, Size: 3, Type: gl.FLOAT},
+		renderer.Attribute{Name: renderer.AttribUV, Location: 1, Size: 2, Type: gl.FLOAT},
+	)
+	quad := renderer.NewMesh(quadVertices, quadIndices, layout, renderer.StaticDraw)
+
+	tex, err := texture.FromImage(checkerboard(64, 8), texture.DefaultOptions())
+	if err != nil {
+		log.Fatal(err)
+	}
+	binder := texture.NewBinder()
+	model := renderer.Identity4()
+
+	gl.ClearColor(0.1, 0.1, 0.1, 1.0)
+
+	for !window.ShouldClose() {
+		if window.GetKey(glfw.KeyEscape) == 1 {
+			window.SetShouldClose(true)
+		}
+		gl.Clear(gl.COLOR_BUFFER_BIT | gl.DEPTH_BUFFER_BIT)
+
+		prog.Use()
+		binder.Bind(tex, prog, "diffuse")
+		prog.SetMat4("model", &model)
+		quad.Draw()
+
+		glfw.PollEvents()
+		window.SwapBuffers()
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestCheckerboard(t *testing.T) {
+	img := checkerboard(4, 2)
+
+	if got, want := img.Bounds().Dx(), 4; got != want {
+		t.Fatalf("width = %d, want %d", got, want)
+	}
+
+	cases := []struct {
+		x, y int
+		want color.Color
+	}{
+		{0, 0, color.White},
+		{1, 0, color.White},
+		{2, 0, color.Black},
+		{0, 2, color.Black},
+		{2, 2, color.White},
+	}
+	for _, c := range cases {
+		gotR, gotG, gotB, gotA := img.At(c.x, c.y).RGBA()
+		wantR, wantG, wantB, wantA := c.want.RGBA()
+		if gotR != wantR || gotG != wantG || gotB != wantB || gotA != wantA {
+			t.Errorf("At(%d,%d) = %v, want %v", c.x, c.y, img.At(c.x, c.y), c.want)
+		}
+	}
+}